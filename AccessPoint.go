@@ -0,0 +1,216 @@
+package gonetworkmanager
+
+import (
+	"encoding/json"
+
+	"github.com/godbus/dbus"
+)
+
+const (
+	AccessPointInterface = NetworkManagerInterface + ".AccessPoint"
+
+	AccessPointPropertyFlags      = AccessPointInterface + ".Flags"
+	AccessPointPropertyWpaFlags   = AccessPointInterface + ".WpaFlags"
+	AccessPointPropertyRsnFlags   = AccessPointInterface + ".RsnFlags"
+	AccessPointPropertySsid       = AccessPointInterface + ".Ssid"
+	AccessPointPropertyFrequency  = AccessPointInterface + ".Frequency"
+	AccessPointPropertyHwAddress  = AccessPointInterface + ".HwAddress"
+	AccessPointPropertyMode       = AccessPointInterface + ".Mode"
+	AccessPointPropertyMaxBitrate = AccessPointInterface + ".MaxBitrate"
+	AccessPointPropertyStrength   = AccessPointInterface + ".Strength"
+	AccessPointPropertyLastSeen   = AccessPointInterface + ".LastSeen"
+)
+
+// 802.11 AP capability flags (NM80211ApFlags).
+const (
+	Nm80211APFlagsNone    = 0x00000000
+	Nm80211APFlagsPrivacy = 0x00000001
+	Nm80211APFlagsWps     = 0x00000002
+)
+
+// 802.11 AP security flags (NM80211ApSecurityFlags), used for WpaFlags/RsnFlags.
+const (
+	Nm80211APSecNone                = 0x00000000
+	Nm80211APSecKeyMgmtPsk          = 0x00000100
+	Nm80211APSecKeyMgmt8021X        = 0x00000200
+	Nm80211APSecKeyMgmtSAE          = 0x00000400
+	Nm80211APSecKeyMgmtOWE          = 0x00000800
+	Nm80211APSecKeyMgmtOWETM        = 0x00001000
+	Nm80211APSecKeyMgmtEAPSuiteB192 = 0x00002000
+)
+
+// AccessPoint represents a wireless access point visible to a DeviceWireless, bound to
+// org.freedesktop.NetworkManager.AccessPoint.
+type AccessPoint interface {
+	GetPath() dbus.ObjectPath
+
+	GetFlags() (uint32, error)
+	GetWpaFlags() (uint32, error)
+	GetRsnFlags() (uint32, error)
+	GetSsid() (string, error)
+	GetFrequency() (uint32, error)
+	GetHwAddress() (string, error)
+	GetMode() (uint32, error)
+	GetMaxBitrate() (uint32, error)
+	GetStrength() (uint8, error)
+	GetLastSeen() (int32, error)
+
+	// SecurityString decodes Flags/WpaFlags/RsnFlags into a human-readable security
+	// description, e.g. "Open", "WEP", "WPA-PSK", "WPA2-PSK", "WPA3-SAE", "WPA-EAP".
+	SecurityString() (string, error)
+
+	MarshalJSON() ([]byte, error)
+}
+
+func NewAccessPoint(objectPath dbus.ObjectPath) (AccessPoint, error) {
+	var a accessPoint
+	return &a, a.init(AccessPointInterface, objectPath)
+}
+
+type accessPoint struct {
+	dbusBase
+}
+
+func (a *accessPoint) GetFlags() (uint32, error) {
+	return a.getUint32Property(AccessPointPropertyFlags)
+}
+
+func (a *accessPoint) GetWpaFlags() (uint32, error) {
+	return a.getUint32Property(AccessPointPropertyWpaFlags)
+}
+
+func (a *accessPoint) GetRsnFlags() (uint32, error) {
+	return a.getUint32Property(AccessPointPropertyRsnFlags)
+}
+
+func (a *accessPoint) GetSsid() (string, error) {
+	ssid, err := a.getSliceByteProperty(AccessPointPropertySsid)
+	if err != nil {
+		return "", err
+	}
+	return string(ssid), nil
+}
+
+func (a *accessPoint) GetFrequency() (uint32, error) {
+	return a.getUint32Property(AccessPointPropertyFrequency)
+}
+
+func (a *accessPoint) GetHwAddress() (string, error) {
+	return a.getStringProperty(AccessPointPropertyHwAddress)
+}
+
+func (a *accessPoint) GetMode() (uint32, error) {
+	return a.getUint32Property(AccessPointPropertyMode)
+}
+
+func (a *accessPoint) GetMaxBitrate() (uint32, error) {
+	return a.getUint32Property(AccessPointPropertyMaxBitrate)
+}
+
+func (a *accessPoint) GetStrength() (uint8, error) {
+	return a.getUint8Property(AccessPointPropertyStrength)
+}
+
+func (a *accessPoint) GetLastSeen() (int32, error) {
+	return a.getInt32Property(AccessPointPropertyLastSeen)
+}
+
+func (a *accessPoint) SecurityString() (string, error) {
+	wpaFlags, err := a.GetWpaFlags()
+	if err != nil {
+		return "", err
+	}
+	rsnFlags, err := a.GetRsnFlags()
+	if err != nil {
+		return "", err
+	}
+	flags, err := a.GetFlags()
+	if err != nil {
+		return "", err
+	}
+
+	return securityString(flags, wpaFlags, rsnFlags), nil
+}
+
+// securityString decodes an access point's Flags/WpaFlags/RsnFlags into a human-readable
+// security description. It's split out from SecurityString so the decoding logic can be
+// unit-tested without a D-Bus connection.
+func securityString(flags, wpaFlags, rsnFlags uint32) string {
+	if wpaFlags == Nm80211APSecNone && rsnFlags == Nm80211APSecNone {
+		if flags&Nm80211APFlagsPrivacy != 0 {
+			return "WEP"
+		}
+		return "Open"
+	}
+
+	if rsnFlags&Nm80211APSecKeyMgmtSAE != 0 {
+		return "WPA3-SAE"
+	}
+	if rsnFlags&Nm80211APSecKeyMgmt8021X != 0 || wpaFlags&Nm80211APSecKeyMgmt8021X != 0 {
+		return "WPA-EAP"
+	}
+	if rsnFlags&Nm80211APSecKeyMgmtPsk != 0 {
+		return "WPA2-PSK"
+	}
+	if wpaFlags&Nm80211APSecKeyMgmtPsk != 0 {
+		return "WPA-PSK"
+	}
+
+	return "Unknown"
+}
+
+func (a *accessPoint) MarshalJSON() ([]byte, error) {
+	ssid, err := a.GetSsid()
+	if err != nil {
+		return nil, err
+	}
+	frequency, err := a.GetFrequency()
+	if err != nil {
+		return nil, err
+	}
+	strength, err := a.GetStrength()
+	if err != nil {
+		return nil, err
+	}
+	security, err := a.SecurityString()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"Ssid":      ssid,
+		"Frequency": frequency,
+		"Strength":  strength,
+		"Security":  security,
+	})
+}
+
+// Frequency2Channel converts a WiFi frequency in MHz to its 802.11 channel number. It returns
+// 0 for frequencies outside 2412-2472 MHz, 2484 MHz and 5000-5895 MHz (channels up to 179).
+func Frequency2Channel(mhz uint32) int {
+	switch {
+	case mhz == 2484:
+		return 14
+	case mhz >= 2412 && mhz <= 2472:
+		return int((mhz - 2407) / 5)
+	case mhz >= 5000 && mhz <= 5895:
+		return int((mhz - 5000) / 5)
+	default:
+		return 0
+	}
+}
+
+// Channel2Frequency converts an 802.11 channel number to its WiFi frequency in MHz. It returns
+// 0 for channels outside the known 2.4GHz (1-14) and 5GHz (36-181) ranges.
+func Channel2Frequency(ch int) uint32 {
+	switch {
+	case ch == 14:
+		return 2484
+	case ch >= 1 && ch <= 13:
+		return uint32(2407 + ch*5)
+	case ch >= 36 && ch <= 181:
+		return uint32(5000 + ch*5)
+	default:
+		return 0
+	}
+}
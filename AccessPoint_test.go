@@ -0,0 +1,71 @@
+package gonetworkmanager
+
+import "testing"
+
+func TestFrequency2Channel(t *testing.T) {
+	cases := []struct {
+		mhz  uint32
+		want int
+	}{
+		{2412, 1},
+		{2472, 13},
+		{2484, 14},
+		{5180, 36},
+		{5895, 179},
+		{2400, 0},
+		{5900, 0},
+	}
+
+	for _, c := range cases {
+		if got := Frequency2Channel(c.mhz); got != c.want {
+			t.Errorf("Frequency2Channel(%d) = %d, want %d", c.mhz, got, c.want)
+		}
+	}
+}
+
+func TestChannel2Frequency(t *testing.T) {
+	cases := []struct {
+		ch   int
+		want uint32
+	}{
+		{1, 2412},
+		{13, 2472},
+		{14, 2484},
+		{36, 5180},
+		{181, 5905},
+		{0, 0},
+		{182, 0},
+	}
+
+	for _, c := range cases {
+		if got := Channel2Frequency(c.ch); got != c.want {
+			t.Errorf("Channel2Frequency(%d) = %d, want %d", c.ch, got, c.want)
+		}
+	}
+}
+
+func TestSecurityString(t *testing.T) {
+	cases := []struct {
+		name     string
+		flags    uint32
+		wpaFlags uint32
+		rsnFlags uint32
+		want     string
+	}{
+		{"open", Nm80211APFlagsNone, Nm80211APSecNone, Nm80211APSecNone, "Open"},
+		{"wep", Nm80211APFlagsPrivacy, Nm80211APSecNone, Nm80211APSecNone, "WEP"},
+		{"wpa-psk", Nm80211APFlagsPrivacy, Nm80211APSecKeyMgmtPsk, Nm80211APSecNone, "WPA-PSK"},
+		{"wpa2-psk", Nm80211APFlagsPrivacy, Nm80211APSecNone, Nm80211APSecKeyMgmtPsk, "WPA2-PSK"},
+		{"wpa3-sae", Nm80211APFlagsPrivacy, Nm80211APSecNone, Nm80211APSecKeyMgmtSAE, "WPA3-SAE"},
+		{"wpa-eap", Nm80211APFlagsPrivacy, Nm80211APSecKeyMgmt8021X, Nm80211APSecNone, "WPA-EAP"},
+		{"mixed-wpa-rsn-psk", Nm80211APFlagsPrivacy, Nm80211APSecKeyMgmtPsk, Nm80211APSecKeyMgmtPsk, "WPA2-PSK"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := securityString(c.flags, c.wpaFlags, c.rsnFlags); got != c.want {
+				t.Errorf("securityString() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package gonetworkmanager
+
+import (
+	"encoding/json"
+
+	"github.com/godbus/dbus"
+)
+
+const (
+	ActiveConnectionInterface = NetworkManagerInterface + ".Connection.Active"
+
+	ActiveConnectionPropertyID          = ActiveConnectionInterface + ".Id"
+	ActiveConnectionPropertyUUID        = ActiveConnectionInterface + ".Uuid"
+	ActiveConnectionPropertyState       = ActiveConnectionInterface + ".State"
+	ActiveConnectionPropertyDevices     = ActiveConnectionInterface + ".Devices"
+	ActiveConnectionPropertyDhcp4Config = ActiveConnectionInterface + ".Dhcp4Config"
+	ActiveConnectionPropertyDhcp6Config = ActiveConnectionInterface + ".Dhcp6Config"
+)
+
+// ActiveConnection represents a connection profile currently activated on one or more devices,
+// bound to org.freedesktop.NetworkManager.Connection.Active.
+type ActiveConnection interface {
+	GetPath() dbus.ObjectPath
+
+	GetID() (string, error)
+	GetUUID() (string, error)
+	GetState() (uint32, error)
+	GetDevices() ([]Device, error)
+
+	// GetDHCP4Config returns the DHCPv4 lease in use on this active connection, or nil if it
+	// has none.
+	GetDHCP4Config() (DHCP4Config, error)
+
+	// GetDHCP6Config returns the DHCPv6 lease in use on this active connection, or nil if it
+	// has none.
+	GetDHCP6Config() (DHCP6Config, error)
+
+	MarshalJSON() ([]byte, error)
+}
+
+func NewActiveConnection(objectPath dbus.ObjectPath) (ActiveConnection, error) {
+	var a activeConnection
+	return &a, a.init(ActiveConnectionInterface, objectPath)
+}
+
+type activeConnection struct {
+	dbusBase
+}
+
+func (a *activeConnection) GetID() (string, error) {
+	return a.getStringProperty(ActiveConnectionPropertyID)
+}
+
+func (a *activeConnection) GetUUID() (string, error) {
+	return a.getStringProperty(ActiveConnectionPropertyUUID)
+}
+
+func (a *activeConnection) GetState() (uint32, error) {
+	return a.getUint32Property(ActiveConnectionPropertyState)
+}
+
+func (a *activeConnection) GetDevices() ([]Device, error) {
+	paths, err := a.getSliceObjectProperty(ActiveConnectionPropertyDevices)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]Device, len(paths))
+	for i, path := range paths {
+		devices[i], err = DeviceFactory(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return devices, nil
+}
+
+func (a *activeConnection) GetDHCP4Config() (DHCP4Config, error) {
+	path, err := a.getObjectProperty(ActiveConnectionPropertyDhcp4Config)
+	if err != nil {
+		return nil, err
+	}
+	if path == "/" || path == "" {
+		return nil, nil
+	}
+	return NewDHCP4Config(path)
+}
+
+func (a *activeConnection) GetDHCP6Config() (DHCP6Config, error) {
+	path, err := a.getObjectProperty(ActiveConnectionPropertyDhcp6Config)
+	if err != nil {
+		return nil, err
+	}
+	if path == "/" || path == "" {
+		return nil, nil
+	}
+	return NewDHCP6Config(path)
+}
+
+func (a *activeConnection) MarshalJSON() ([]byte, error) {
+	id, err := a.GetID()
+	if err != nil {
+		return nil, err
+	}
+	state, err := a.GetState()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"Id":    id,
+		"State": state,
+	})
+}
@@ -0,0 +1,89 @@
+package gonetworkmanager
+
+import (
+	"encoding/json"
+
+	"github.com/godbus/dbus"
+)
+
+const (
+	CheckpointInterface = NetworkManagerInterface + ".Checkpoint"
+
+	CheckpointPropertyDevices         = CheckpointInterface + ".Devices"
+	CheckpointPropertyCreated         = CheckpointInterface + ".Created"
+	CheckpointPropertyRollbackTimeout = CheckpointInterface + ".RollbackTimeout"
+)
+
+// Checkpoint represents a snapshot of the configuration of a set of devices, as created by
+// NetworkManager.CheckpointCreate. It can be used to roll back to that configuration at a
+// later time, either explicitly or automatically once its rollback timeout elapses.
+type Checkpoint interface {
+	GetPath() dbus.ObjectPath
+
+	// GetDevices returns the devices that are part of this checkpoint.
+	GetDevices() ([]Device, error)
+
+	// GetCreated returns the timestamp (in CLOCK_BOOTTIME milliseconds) of checkpoint creation.
+	GetCreated() (int64, error)
+
+	// GetRollbackTimeout returns the timeout (in seconds since checkpoint creation) for
+	// automatic rollback, or zero if no automatic rollback is scheduled.
+	GetRollbackTimeout() (uint32, error)
+
+	MarshalJSON() ([]byte, error)
+}
+
+func NewCheckpoint(objectPath dbus.ObjectPath) (Checkpoint, error) {
+	var c checkpoint
+	return &c, c.init(CheckpointInterface, objectPath)
+}
+
+type checkpoint struct {
+	dbusBase
+}
+
+func (c *checkpoint) GetDevices() ([]Device, error) {
+	devicePaths, err := c.getSliceObjectProperty(CheckpointPropertyDevices)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]Device, len(devicePaths))
+	for i, path := range devicePaths {
+		devices[i], err = DeviceFactory(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return devices, nil
+}
+
+func (c *checkpoint) GetCreated() (int64, error) {
+	return c.getInt64Property(CheckpointPropertyCreated)
+}
+
+func (c *checkpoint) GetRollbackTimeout() (uint32, error) {
+	return c.getUint32Property(CheckpointPropertyRollbackTimeout)
+}
+
+func (c *checkpoint) MarshalJSON() ([]byte, error) {
+	devices, err := c.GetDevices()
+	if err != nil {
+		return nil, err
+	}
+	created, err := c.GetCreated()
+	if err != nil {
+		return nil, err
+	}
+	rollbackTimeout, err := c.GetRollbackTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"Devices":         devices,
+		"Created":         created,
+		"RollbackTimeout": rollbackTimeout,
+	})
+}
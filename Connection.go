@@ -0,0 +1,75 @@
+package gonetworkmanager
+
+import (
+	"encoding/json"
+
+	"github.com/godbus/dbus"
+)
+
+const (
+	ConnectionInterface = NetworkManagerInterface + ".Settings.Connection"
+
+	ConnectionUpdate      = ConnectionInterface + ".Update"
+	ConnectionDelete      = ConnectionInterface + ".Delete"
+	ConnectionGetSettings = ConnectionInterface + ".GetSettings"
+	ConnectionGetSecrets  = ConnectionInterface + ".GetSecrets"
+)
+
+// Connection represents a single saved connection profile, bound to
+// org.freedesktop.NetworkManager.Settings.Connection.
+type Connection interface {
+	GetPath() dbus.ObjectPath
+
+	// GetSettings returns the connection profile as currently stored by NetworkManager.
+	GetSettings() (ConnectionSettings, error)
+
+	// GetSecrets returns the secrets (e.g. PSK, password) for the given setting name
+	// (e.g. "802-11-wireless-security"), which are omitted from GetSettings.
+	GetSecrets(settingName string) (ConnectionSettings, error)
+
+	// Update replaces the connection profile with the given settings.
+	Update(settings ConnectionSettings) error
+
+	// Delete removes the connection profile.
+	Delete() error
+
+	MarshalJSON() ([]byte, error)
+}
+
+func NewConnection(objectPath dbus.ObjectPath) (Connection, error) {
+	var c connection
+	return &c, c.init(ConnectionInterface, objectPath)
+}
+
+type connection struct {
+	dbusBase
+}
+
+func (c *connection) GetSettings() (ConnectionSettings, error) {
+	var settings map[string]map[string]interface{}
+	err := c.call(&settings, ConnectionGetSettings)
+	return ConnectionSettings(settings), err
+}
+
+func (c *connection) GetSecrets(settingName string) (ConnectionSettings, error) {
+	var secrets map[string]map[string]interface{}
+	err := c.call(&secrets, ConnectionGetSecrets, settingName)
+	return ConnectionSettings(secrets), err
+}
+
+func (c *connection) Update(settings ConnectionSettings) error {
+	return c.call(nil, ConnectionUpdate, map[string]map[string]interface{}(settings))
+}
+
+func (c *connection) Delete() error {
+	return c.call(nil, ConnectionDelete)
+}
+
+func (c *connection) MarshalJSON() ([]byte, error) {
+	settings, err := c.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(settings)
+}
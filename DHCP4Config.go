@@ -0,0 +1,158 @@
+package gonetworkmanager
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/godbus/dbus"
+)
+
+const (
+	DHCP4ConfigInterface = NetworkManagerInterface + ".DHCP4Config"
+
+	DHCP4ConfigPropertyOptions = DHCP4ConfigInterface + ".Options"
+
+	// Well-known keys of the DHCP4Config Options dictionary, as documented by NetworkManager.
+	DHCP4OptionServerIdentifier  = "dhcp_server_identifier"
+	DHCP4OptionIPAddress         = "ip_address"
+	DHCP4OptionSubnetMask        = "subnet_mask"
+	DHCP4OptionRouters           = "routers"
+	DHCP4OptionDomainNameServers = "domain_name_servers"
+	DHCP4OptionNextServer        = "next_server"
+	DHCP4OptionNextHop           = "next_hop"
+	DHCP4OptionLeaseTime         = "dhcp_lease_time"
+)
+
+// DHCP4Config wraps a DHCPv4 lease as handed out by the DHCP client NetworkManager is using
+// for a given device or active connection.
+type DHCP4Config interface {
+	// GetOptions returns the raw DHCP option dictionary, keyed by option name (e.g.
+	// "dhcp_server_identifier", "routers", "domain_name_servers").
+	GetOptions() (map[string]interface{}, error)
+
+	// GetOption returns the string value of a single DHCP option, and whether it was present.
+	GetOption(name string) (string, bool)
+
+	// ServerIdentifier returns the "dhcp_server_identifier" option.
+	ServerIdentifier() (string, bool)
+
+	// IPAddress returns the "ip_address" option.
+	IPAddress() (string, bool)
+
+	// SubnetMask returns the "subnet_mask" option.
+	SubnetMask() (string, bool)
+
+	// Routers returns the "routers" option, split on whitespace into individual addresses.
+	Routers() ([]string, bool)
+
+	// DomainNameServers returns the "domain_name_servers" option, split on whitespace into
+	// individual addresses.
+	DomainNameServers() ([]string, bool)
+
+	// NextServer returns the "next_server" option.
+	NextServer() (string, bool)
+
+	// NextHop returns the "next_hop" option.
+	NextHop() (string, bool)
+
+	// LeaseTime returns the "dhcp_lease_time" option, in seconds.
+	LeaseTime() (int, bool)
+
+	MarshalJSON() ([]byte, error)
+}
+
+func NewDHCP4Config(objectPath dbus.ObjectPath) (DHCP4Config, error) {
+	var c dhcp4Config
+	return &c, c.init(DHCP4ConfigInterface, objectPath)
+}
+
+type dhcp4Config struct {
+	dbusBase
+}
+
+func (c *dhcp4Config) GetOptions() (map[string]interface{}, error) {
+	variants, err := c.getMapStringVariantProperty(DHCP4ConfigPropertyOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make(map[string]interface{}, len(variants))
+	for name, value := range variants {
+		options[name] = value.Value()
+	}
+
+	return options, nil
+}
+
+func (c *dhcp4Config) GetOption(name string) (string, bool) {
+	options, err := c.GetOptions()
+	if err != nil {
+		return "", false
+	}
+
+	value, ok := options[name]
+	if !ok {
+		return "", false
+	}
+
+	str, ok := value.(string)
+	return str, ok
+}
+
+func (c *dhcp4Config) ServerIdentifier() (string, bool) {
+	return c.GetOption(DHCP4OptionServerIdentifier)
+}
+
+func (c *dhcp4Config) IPAddress() (string, bool) {
+	return c.GetOption(DHCP4OptionIPAddress)
+}
+
+func (c *dhcp4Config) SubnetMask() (string, bool) {
+	return c.GetOption(DHCP4OptionSubnetMask)
+}
+
+func (c *dhcp4Config) Routers() ([]string, bool) {
+	return c.getOptionList(DHCP4OptionRouters)
+}
+
+func (c *dhcp4Config) DomainNameServers() ([]string, bool) {
+	return c.getOptionList(DHCP4OptionDomainNameServers)
+}
+
+func (c *dhcp4Config) NextServer() (string, bool) {
+	return c.GetOption(DHCP4OptionNextServer)
+}
+
+func (c *dhcp4Config) NextHop() (string, bool) {
+	return c.GetOption(DHCP4OptionNextHop)
+}
+
+func (c *dhcp4Config) LeaseTime() (int, bool) {
+	value, ok := c.GetOption(DHCP4OptionLeaseTime)
+	if !ok {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return seconds, true
+}
+
+func (c *dhcp4Config) getOptionList(name string) ([]string, bool) {
+	value, ok := c.GetOption(name)
+	if !ok {
+		return nil, false
+	}
+	return strings.Fields(value), true
+}
+
+func (c *dhcp4Config) MarshalJSON() ([]byte, error) {
+	options, err := c.GetOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(options)
+}
@@ -0,0 +1,147 @@
+package gonetworkmanager
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/godbus/dbus"
+)
+
+const (
+	DHCP6ConfigInterface = NetworkManagerInterface + ".DHCP6Config"
+
+	DHCP6ConfigPropertyOptions = DHCP6ConfigInterface + ".Options"
+
+	// Well-known keys of the DHCP6Config Options dictionary, as documented by NetworkManager.
+	DHCP6OptionServerId      = "dhcp6_server_id"
+	DHCP6OptionIPAddress     = "dhcp6_ip_address"
+	DHCP6OptionNameServers   = "dhcp6_name_servers"
+	DHCP6OptionDomainSearch  = "dhcp6_domain_search"
+	DHCP6OptionPreferredLife = "dhcp6_preferred_life"
+	DHCP6OptionLeaseTime     = "dhcp6_max_life"
+)
+
+// DHCP6Config wraps a DHCPv6 lease as handed out by the DHCP client NetworkManager is using
+// for a given device or active connection.
+type DHCP6Config interface {
+	// GetOptions returns the raw DHCP option dictionary, keyed by option name (e.g.
+	// "dhcp6_server_id", "dhcp6_name_servers").
+	GetOptions() (map[string]interface{}, error)
+
+	// GetOption returns the string value of a single DHCP option, and whether it was present.
+	GetOption(name string) (string, bool)
+
+	// ServerId returns the "dhcp6_server_id" option.
+	ServerId() (string, bool)
+
+	// IPAddress returns the "dhcp6_ip_address" option.
+	IPAddress() (string, bool)
+
+	// NameServers returns the "dhcp6_name_servers" option, split on whitespace into individual
+	// addresses.
+	NameServers() ([]string, bool)
+
+	// DomainSearch returns the "dhcp6_domain_search" option, split on whitespace into
+	// individual domains.
+	DomainSearch() ([]string, bool)
+
+	// PreferredLife returns the "dhcp6_preferred_life" option, in seconds.
+	PreferredLife() (int, bool)
+
+	// LeaseTime returns the "dhcp6_max_life" option, in seconds.
+	LeaseTime() (int, bool)
+
+	MarshalJSON() ([]byte, error)
+}
+
+func NewDHCP6Config(objectPath dbus.ObjectPath) (DHCP6Config, error) {
+	var c dhcp6Config
+	return &c, c.init(DHCP6ConfigInterface, objectPath)
+}
+
+type dhcp6Config struct {
+	dbusBase
+}
+
+func (c *dhcp6Config) GetOptions() (map[string]interface{}, error) {
+	variants, err := c.getMapStringVariantProperty(DHCP6ConfigPropertyOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make(map[string]interface{}, len(variants))
+	for name, value := range variants {
+		options[name] = value.Value()
+	}
+
+	return options, nil
+}
+
+func (c *dhcp6Config) GetOption(name string) (string, bool) {
+	options, err := c.GetOptions()
+	if err != nil {
+		return "", false
+	}
+
+	value, ok := options[name]
+	if !ok {
+		return "", false
+	}
+
+	str, ok := value.(string)
+	return str, ok
+}
+
+func (c *dhcp6Config) ServerId() (string, bool) {
+	return c.GetOption(DHCP6OptionServerId)
+}
+
+func (c *dhcp6Config) IPAddress() (string, bool) {
+	return c.GetOption(DHCP6OptionIPAddress)
+}
+
+func (c *dhcp6Config) NameServers() ([]string, bool) {
+	return c.getOptionList(DHCP6OptionNameServers)
+}
+
+func (c *dhcp6Config) DomainSearch() ([]string, bool) {
+	return c.getOptionList(DHCP6OptionDomainSearch)
+}
+
+func (c *dhcp6Config) PreferredLife() (int, bool) {
+	return c.getOptionInt(DHCP6OptionPreferredLife)
+}
+
+func (c *dhcp6Config) LeaseTime() (int, bool) {
+	return c.getOptionInt(DHCP6OptionLeaseTime)
+}
+
+func (c *dhcp6Config) getOptionList(name string) ([]string, bool) {
+	value, ok := c.GetOption(name)
+	if !ok {
+		return nil, false
+	}
+	return strings.Fields(value), true
+}
+
+func (c *dhcp6Config) getOptionInt(name string) (int, bool) {
+	value, ok := c.GetOption(name)
+	if !ok {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return seconds, true
+}
+
+func (c *dhcp6Config) MarshalJSON() ([]byte, error) {
+	options, err := c.GetOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(options)
+}
@@ -0,0 +1,195 @@
+package gonetworkmanager
+
+import (
+	"encoding/json"
+	"encoding/xml"
+
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceInterface = NetworkManagerInterface + ".Device"
+
+	DevicePropertyInterface   = DeviceInterface + ".Interface"
+	DevicePropertyIpInterface = DeviceInterface + ".IpInterface"
+	DevicePropertyDeviceType  = DeviceInterface + ".DeviceType"
+	DevicePropertyManaged     = DeviceInterface + ".Managed"
+	DevicePropertyDhcp4Config = DeviceInterface + ".Dhcp4Config"
+	DevicePropertyDhcp6Config = DeviceInterface + ".Dhcp6Config"
+
+	introspectableInterface  = "org.freedesktop.DBus.Introspectable"
+	introspectableIntrospect = introspectableInterface + ".Introspect"
+)
+
+// NmDeviceType enumerates org.freedesktop.NetworkManager.Device's DeviceType property
+// (NMDeviceType in the NetworkManager D-Bus API reference).
+type NmDeviceType uint32
+
+const (
+	NmDeviceTypeUnknown      NmDeviceType = 0
+	NmDeviceTypeEthernet     NmDeviceType = 1
+	NmDeviceTypeWifi         NmDeviceType = 2
+	NmDeviceTypeBond         NmDeviceType = 10
+	NmDeviceTypeVlan         NmDeviceType = 11
+	NmDeviceTypeBridge       NmDeviceType = 13
+	NmDeviceTypeTun          NmDeviceType = 16
+	NmDeviceTypeIPTunnel     NmDeviceType = 17
+	NmDeviceTypeMacvlan      NmDeviceType = 18
+	NmDeviceTypeGeneric      NmDeviceType = 14
+	NmDeviceTypeOvsInterface NmDeviceType = 24
+	NmDeviceTypeWireGuard    NmDeviceType = 29
+	NmDeviceTypeDummy        NmDeviceType = 22
+)
+
+// Device is the common, interface-agnostic surface every NetworkManager device exposes,
+// bound to org.freedesktop.NetworkManager.Device. DeviceFactory returns the most specific
+// wrapper it can determine for a given device path; every one of those wrappers embeds Device.
+type Device interface {
+	GetPath() dbus.ObjectPath
+
+	// GetInterface returns the kernel network interface name (e.g. "eth0", "wlan0").
+	GetInterface() (string, error)
+
+	// GetIpInterface returns the IP interface name, which may differ from Interface for
+	// devices where IP configuration happens on a separate interface (e.g. PPP).
+	GetIpInterface() (string, error)
+
+	// GetDeviceType returns the device's NMDeviceType.
+	GetDeviceType() (NmDeviceType, error)
+
+	// GetDHCP4Config returns the device's current DHCPv4 lease, or nil if it has none.
+	GetDHCP4Config() (DHCP4Config, error)
+
+	// GetDHCP6Config returns the device's current DHCPv6 lease, or nil if it has none.
+	GetDHCP6Config() (DHCP6Config, error)
+
+	MarshalJSON() ([]byte, error)
+}
+
+func newDevice(objectPath dbus.ObjectPath) (*device, error) {
+	var d device
+	return &d, d.init(DeviceInterface, objectPath)
+}
+
+type device struct {
+	dbusBase
+}
+
+func (d *device) GetInterface() (string, error) {
+	return d.getStringProperty(DevicePropertyInterface)
+}
+
+func (d *device) GetIpInterface() (string, error) {
+	return d.getStringProperty(DevicePropertyIpInterface)
+}
+
+func (d *device) GetDeviceType() (NmDeviceType, error) {
+	t, err := d.getUint32Property(DevicePropertyDeviceType)
+	return NmDeviceType(t), err
+}
+
+func (d *device) GetDHCP4Config() (DHCP4Config, error) {
+	path, err := d.getObjectProperty(DevicePropertyDhcp4Config)
+	if err != nil {
+		return nil, err
+	}
+	if path == "/" || path == "" {
+		return nil, nil
+	}
+	return NewDHCP4Config(path)
+}
+
+func (d *device) GetDHCP6Config() (DHCP6Config, error) {
+	path, err := d.getObjectProperty(DevicePropertyDhcp6Config)
+	if err != nil {
+		return nil, err
+	}
+	if path == "/" || path == "" {
+		return nil, nil
+	}
+	return NewDHCP6Config(path)
+}
+
+func (d *device) MarshalJSON() ([]byte, error) {
+	iface, err := d.GetInterface()
+	if err != nil {
+		return nil, err
+	}
+	deviceType, err := d.GetDeviceType()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"Interface":  iface,
+		"DeviceType": deviceType,
+	})
+}
+
+func (d *device) introspectInterfaces() (map[string]bool, error) {
+	var xmlData string
+	if err := d.call(&xmlData, introspectableIntrospect); err != nil {
+		return nil, err
+	}
+
+	var node struct {
+		Interfaces []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"interface"`
+	}
+	if err := xml.Unmarshal([]byte(xmlData), &node); err != nil {
+		return nil, err
+	}
+
+	interfaces := make(map[string]bool, len(node.Interfaces))
+	for _, i := range node.Interfaces {
+		interfaces[i.Name] = true
+	}
+	return interfaces, nil
+}
+
+// deviceWrappers maps each org.freedesktop.NetworkManager.Device.* sub-interface to the
+// constructor for its dedicated wrapper. DeviceFactory introspects a device path and returns
+// the wrapper for the first of these sub-interfaces the device implements, in order, falling
+// back to DeviceGeneric if none match.
+var deviceWrappers = []struct {
+	iface       string
+	constructor func(device) Device
+}{
+	{DeviceWirelessInterface, func(d device) Device { return &deviceWireless{device: d} }},
+	{DeviceEthernetInterface, func(d device) Device { return &deviceEthernet{device: d} }},
+	{DeviceBridgeInterface, func(d device) Device { return &deviceBridge{device: d} }},
+	{DeviceBondInterface, func(d device) Device { return &deviceBond{device: d} }},
+	{DeviceVlanInterface, func(d device) Device { return &deviceVlan{device: d} }},
+	{DeviceWireGuardInterface, func(d device) Device { return &deviceWireGuard{device: d} }},
+	{DeviceIPTunnelInterface, func(d device) Device { return &deviceIPTunnel{device: d} }},
+	{DeviceMacvlanInterface, func(d device) Device { return &deviceMacvlan{device: d} }},
+	{DeviceOvsInterfaceInterface, func(d device) Device { return &deviceOvsInterface{device: d} }},
+	{DeviceTunInterface, func(d device) Device { return &deviceTun{device: d} }},
+	{DeviceDummyInterface, func(d device) Device { return &deviceDummy{device: d} }},
+}
+
+// DeviceFactory returns the most specific Device wrapper available for objectPath, determined
+// by introspecting which org.freedesktop.NetworkManager.Device.* sub-interface it implements.
+// Devices that don't match any known sub-interface get a DeviceGeneric wrapper. Errors from
+// introspection itself (D-Bus timeout, permission denied, connection drop, ...) are returned
+// to the caller rather than masked as an unrecognized device type.
+func DeviceFactory(objectPath dbus.ObjectPath) (Device, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces, err := d.introspectInterfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wrapper := range deviceWrappers {
+		if interfaces[wrapper.iface] {
+			return wrapper.constructor(*d), nil
+		}
+	}
+
+	return &deviceGeneric{device: *d}, nil
+}
@@ -0,0 +1,61 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceBondInterface = NetworkManagerInterface + ".Device.Bond"
+
+	DeviceBondPropertyHwAddress = DeviceBondInterface + ".HwAddress"
+	DeviceBondPropertyCarrier   = DeviceBondInterface + ".Carrier"
+	DeviceBondPropertySlaves    = DeviceBondInterface + ".Slaves"
+)
+
+// DeviceBond wraps a bonded device, bound to org.freedesktop.NetworkManager.Device.Bond.
+type DeviceBond interface {
+	Device
+
+	GetHwAddress() (string, error)
+	GetCarrier() (bool, error)
+
+	// GetSlaves returns the devices currently enslaved to this bond.
+	GetSlaves() ([]Device, error)
+}
+
+func NewDeviceBond(objectPath dbus.ObjectPath) (DeviceBond, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceBond{device: *d}, nil
+}
+
+type deviceBond struct {
+	device
+}
+
+func (d *deviceBond) GetHwAddress() (string, error) {
+	return d.getStringProperty(DeviceBondPropertyHwAddress)
+}
+
+func (d *deviceBond) GetCarrier() (bool, error) {
+	return d.getBoolProperty(DeviceBondPropertyCarrier)
+}
+
+func (d *deviceBond) GetSlaves() ([]Device, error) {
+	paths, err := d.getSliceObjectProperty(DeviceBondPropertySlaves)
+	if err != nil {
+		return nil, err
+	}
+
+	slaves := make([]Device, len(paths))
+	for i, path := range paths {
+		slaves[i], err = DeviceFactory(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return slaves, nil
+}
@@ -0,0 +1,62 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceBridgeInterface = NetworkManagerInterface + ".Device.Bridge"
+
+	DeviceBridgePropertyHwAddress = DeviceBridgeInterface + ".HwAddress"
+	DeviceBridgePropertyCarrier   = DeviceBridgeInterface + ".Carrier"
+	DeviceBridgePropertySlaves    = DeviceBridgeInterface + ".Slaves"
+)
+
+// DeviceBridge wraps a software bridge device, bound to
+// org.freedesktop.NetworkManager.Device.Bridge.
+type DeviceBridge interface {
+	Device
+
+	GetHwAddress() (string, error)
+	GetCarrier() (bool, error)
+
+	// GetSlaves returns the devices currently enslaved to this bridge.
+	GetSlaves() ([]Device, error)
+}
+
+func NewDeviceBridge(objectPath dbus.ObjectPath) (DeviceBridge, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceBridge{device: *d}, nil
+}
+
+type deviceBridge struct {
+	device
+}
+
+func (d *deviceBridge) GetHwAddress() (string, error) {
+	return d.getStringProperty(DeviceBridgePropertyHwAddress)
+}
+
+func (d *deviceBridge) GetCarrier() (bool, error) {
+	return d.getBoolProperty(DeviceBridgePropertyCarrier)
+}
+
+func (d *deviceBridge) GetSlaves() ([]Device, error) {
+	paths, err := d.getSliceObjectProperty(DeviceBridgePropertySlaves)
+	if err != nil {
+		return nil, err
+	}
+
+	slaves := make([]Device, len(paths))
+	for i, path := range paths {
+		slaves[i], err = DeviceFactory(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return slaves, nil
+}
@@ -0,0 +1,34 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceDummyInterface = NetworkManagerInterface + ".Device.Dummy"
+
+	DeviceDummyPropertyHwAddress = DeviceDummyInterface + ".HwAddress"
+)
+
+// DeviceDummy wraps a dummy device, bound to org.freedesktop.NetworkManager.Device.Dummy.
+type DeviceDummy interface {
+	Device
+
+	GetHwAddress() (string, error)
+}
+
+func NewDeviceDummy(objectPath dbus.ObjectPath) (DeviceDummy, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceDummy{device: *d}, nil
+}
+
+type deviceDummy struct {
+	device
+}
+
+func (d *deviceDummy) GetHwAddress() (string, error) {
+	return d.getStringProperty(DeviceDummyPropertyHwAddress)
+}
@@ -0,0 +1,53 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceEthernetInterface = NetworkManagerInterface + ".Device.Wired"
+
+	DeviceEthernetPropertyHwAddress     = DeviceEthernetInterface + ".HwAddress"
+	DeviceEthernetPropertyPermHwAddress = DeviceEthernetInterface + ".PermHwAddress"
+	DeviceEthernetPropertySpeed         = DeviceEthernetInterface + ".Speed"
+	DeviceEthernetPropertyCarrier       = DeviceEthernetInterface + ".Carrier"
+)
+
+// DeviceEthernet wraps a wired Ethernet device, bound to
+// org.freedesktop.NetworkManager.Device.Wired.
+type DeviceEthernet interface {
+	Device
+
+	GetHwAddress() (string, error)
+	GetPermHwAddress() (string, error)
+	GetSpeed() (uint32, error)
+	GetCarrier() (bool, error)
+}
+
+func NewDeviceEthernet(objectPath dbus.ObjectPath) (DeviceEthernet, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceEthernet{device: *d}, nil
+}
+
+type deviceEthernet struct {
+	device
+}
+
+func (d *deviceEthernet) GetHwAddress() (string, error) {
+	return d.getStringProperty(DeviceEthernetPropertyHwAddress)
+}
+
+func (d *deviceEthernet) GetPermHwAddress() (string, error) {
+	return d.getStringProperty(DeviceEthernetPropertyPermHwAddress)
+}
+
+func (d *deviceEthernet) GetSpeed() (uint32, error) {
+	return d.getUint32Property(DeviceEthernetPropertySpeed)
+}
+
+func (d *deviceEthernet) GetCarrier() (bool, error) {
+	return d.getBoolProperty(DeviceEthernetPropertyCarrier)
+}
@@ -0,0 +1,42 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceGenericInterface = NetworkManagerInterface + ".Device.Generic"
+
+	DeviceGenericPropertyTypeDescription = DeviceGenericInterface + ".TypeDescription"
+	DeviceGenericPropertyHwAddress       = DeviceGenericInterface + ".HwAddress"
+)
+
+// DeviceGeneric wraps a device of a type NetworkManager doesn't have a dedicated D-Bus
+// sub-interface for, bound to org.freedesktop.NetworkManager.Device.Generic. DeviceFactory
+// falls back to this wrapper whenever introspection doesn't match any known sub-interface.
+type DeviceGeneric interface {
+	Device
+
+	GetTypeDescription() (string, error)
+	GetHwAddress() (string, error)
+}
+
+func NewDeviceGeneric(objectPath dbus.ObjectPath) (DeviceGeneric, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceGeneric{device: *d}, nil
+}
+
+type deviceGeneric struct {
+	device
+}
+
+func (d *deviceGeneric) GetTypeDescription() (string, error) {
+	return d.getStringProperty(DeviceGenericPropertyTypeDescription)
+}
+
+func (d *deviceGeneric) GetHwAddress() (string, error) {
+	return d.getStringProperty(DeviceGenericPropertyHwAddress)
+}
@@ -0,0 +1,65 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceIPTunnelInterface = NetworkManagerInterface + ".Device.IPTunnel"
+
+	DeviceIPTunnelPropertyMode   = DeviceIPTunnelInterface + ".Mode"
+	DeviceIPTunnelPropertyParent = DeviceIPTunnelInterface + ".Parent"
+	DeviceIPTunnelPropertyLocal  = DeviceIPTunnelInterface + ".Local"
+	DeviceIPTunnelPropertyRemote = DeviceIPTunnelInterface + ".Remote"
+	DeviceIPTunnelPropertyTtl    = DeviceIPTunnelInterface + ".Ttl"
+)
+
+// DeviceIPTunnel wraps an IP tunnel device (e.g. GRE, IPIP, SIT), bound to
+// org.freedesktop.NetworkManager.Device.IPTunnel.
+type DeviceIPTunnel interface {
+	Device
+
+	// GetMode returns the tunnel mode (NM_IP_TUNNEL_MODE_*, e.g. gre, ipip, sit, vti).
+	GetMode() (uint32, error)
+
+	GetParent() (Device, error)
+	GetLocal() (string, error)
+	GetRemote() (string, error)
+	GetTtl() (uint8, error)
+}
+
+func NewDeviceIPTunnel(objectPath dbus.ObjectPath) (DeviceIPTunnel, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceIPTunnel{device: *d}, nil
+}
+
+type deviceIPTunnel struct {
+	device
+}
+
+func (d *deviceIPTunnel) GetMode() (uint32, error) {
+	return d.getUint32Property(DeviceIPTunnelPropertyMode)
+}
+
+func (d *deviceIPTunnel) GetParent() (Device, error) {
+	path, err := d.getObjectProperty(DeviceIPTunnelPropertyParent)
+	if err != nil {
+		return nil, err
+	}
+	return DeviceFactory(path)
+}
+
+func (d *deviceIPTunnel) GetLocal() (string, error) {
+	return d.getStringProperty(DeviceIPTunnelPropertyLocal)
+}
+
+func (d *deviceIPTunnel) GetRemote() (string, error) {
+	return d.getStringProperty(DeviceIPTunnelPropertyRemote)
+}
+
+func (d *deviceIPTunnel) GetTtl() (uint8, error) {
+	return d.getUint8Property(DeviceIPTunnelPropertyTtl)
+}
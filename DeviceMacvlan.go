@@ -0,0 +1,59 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceMacvlanInterface = NetworkManagerInterface + ".Device.Macvlan"
+
+	DeviceMacvlanPropertyParent    = DeviceMacvlanInterface + ".Parent"
+	DeviceMacvlanPropertyMode      = DeviceMacvlanInterface + ".Mode"
+	DeviceMacvlanPropertyNoPromisc = DeviceMacvlanInterface + ".NoPromisc"
+	DeviceMacvlanPropertyTap       = DeviceMacvlanInterface + ".Tap"
+)
+
+// DeviceMacvlan wraps a macvlan/macvtap device, bound to
+// org.freedesktop.NetworkManager.Device.Macvlan.
+type DeviceMacvlan interface {
+	Device
+
+	GetParent() (Device, error)
+	GetMode() (string, error)
+	GetNoPromisc() (bool, error)
+
+	// GetTap reports whether this is a macvtap (true) or macvlan (false) device.
+	GetTap() (bool, error)
+}
+
+func NewDeviceMacvlan(objectPath dbus.ObjectPath) (DeviceMacvlan, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceMacvlan{device: *d}, nil
+}
+
+type deviceMacvlan struct {
+	device
+}
+
+func (d *deviceMacvlan) GetParent() (Device, error) {
+	path, err := d.getObjectProperty(DeviceMacvlanPropertyParent)
+	if err != nil {
+		return nil, err
+	}
+	return DeviceFactory(path)
+}
+
+func (d *deviceMacvlan) GetMode() (string, error) {
+	return d.getStringProperty(DeviceMacvlanPropertyMode)
+}
+
+func (d *deviceMacvlan) GetNoPromisc() (bool, error) {
+	return d.getBoolProperty(DeviceMacvlanPropertyNoPromisc)
+}
+
+func (d *deviceMacvlan) GetTap() (bool, error) {
+	return d.getBoolProperty(DeviceMacvlanPropertyTap)
+}
@@ -0,0 +1,31 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const DeviceOvsInterfaceInterface = NetworkManagerInterface + ".Device.OvsInterface"
+
+// DeviceOvsInterface wraps an Open vSwitch interface device, bound to
+// org.freedesktop.NetworkManager.Device.OvsInterface. The D-Bus interface exposes no
+// properties of its own beyond those on Device; it exists so kube-ovn-style consumers can
+// tell OVS interfaces apart from other device types via a type assertion.
+type DeviceOvsInterface interface {
+	Device
+
+	isOvsInterface()
+}
+
+func NewDeviceOvsInterface(objectPath dbus.ObjectPath) (DeviceOvsInterface, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceOvsInterface{device: *d}, nil
+}
+
+type deviceOvsInterface struct {
+	device
+}
+
+func (d *deviceOvsInterface) isOvsInterface() {}
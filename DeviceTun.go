@@ -0,0 +1,61 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceTunInterface = NetworkManagerInterface + ".Device.Tun"
+
+	DeviceTunPropertyHwAddress  = DeviceTunInterface + ".HwAddress"
+	DeviceTunPropertyOwner      = DeviceTunInterface + ".Owner"
+	DeviceTunPropertyGroup      = DeviceTunInterface + ".Group"
+	DeviceTunPropertyMode       = DeviceTunInterface + ".Mode"
+	DeviceTunPropertyMultiQueue = DeviceTunInterface + ".MultiQueue"
+)
+
+// DeviceTun wraps a TUN/TAP device, bound to org.freedesktop.NetworkManager.Device.Tun.
+type DeviceTun interface {
+	Device
+
+	GetHwAddress() (string, error)
+	GetOwner() (int64, error)
+	GetGroup() (int64, error)
+
+	// GetMode returns "tun" or "tap".
+	GetMode() (string, error)
+
+	GetMultiQueue() (bool, error)
+}
+
+func NewDeviceTun(objectPath dbus.ObjectPath) (DeviceTun, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceTun{device: *d}, nil
+}
+
+type deviceTun struct {
+	device
+}
+
+func (d *deviceTun) GetHwAddress() (string, error) {
+	return d.getStringProperty(DeviceTunPropertyHwAddress)
+}
+
+func (d *deviceTun) GetOwner() (int64, error) {
+	return d.getInt64Property(DeviceTunPropertyOwner)
+}
+
+func (d *deviceTun) GetGroup() (int64, error) {
+	return d.getInt64Property(DeviceTunPropertyGroup)
+}
+
+func (d *deviceTun) GetMode() (string, error) {
+	return d.getStringProperty(DeviceTunPropertyMode)
+}
+
+func (d *deviceTun) GetMultiQueue() (bool, error) {
+	return d.getBoolProperty(DeviceTunPropertyMultiQueue)
+}
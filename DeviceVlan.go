@@ -0,0 +1,56 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceVlanInterface = NetworkManagerInterface + ".Device.Vlan"
+
+	DeviceVlanPropertyHwAddress = DeviceVlanInterface + ".HwAddress"
+	DeviceVlanPropertyCarrier   = DeviceVlanInterface + ".Carrier"
+	DeviceVlanPropertyParent    = DeviceVlanInterface + ".Parent"
+	DeviceVlanPropertyVlanId    = DeviceVlanInterface + ".VlanId"
+)
+
+// DeviceVlan wraps a VLAN device, bound to org.freedesktop.NetworkManager.Device.Vlan.
+type DeviceVlan interface {
+	Device
+
+	GetHwAddress() (string, error)
+	GetCarrier() (bool, error)
+	GetParent() (Device, error)
+	GetVlanId() (uint32, error)
+}
+
+func NewDeviceVlan(objectPath dbus.ObjectPath) (DeviceVlan, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceVlan{device: *d}, nil
+}
+
+type deviceVlan struct {
+	device
+}
+
+func (d *deviceVlan) GetHwAddress() (string, error) {
+	return d.getStringProperty(DeviceVlanPropertyHwAddress)
+}
+
+func (d *deviceVlan) GetCarrier() (bool, error) {
+	return d.getBoolProperty(DeviceVlanPropertyCarrier)
+}
+
+func (d *deviceVlan) GetParent() (Device, error) {
+	path, err := d.getObjectProperty(DeviceVlanPropertyParent)
+	if err != nil {
+		return nil, err
+	}
+	return DeviceFactory(path)
+}
+
+func (d *deviceVlan) GetVlanId() (uint32, error) {
+	return d.getUint32Property(DeviceVlanPropertyVlanId)
+}
@@ -0,0 +1,70 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceWireGuardInterface = NetworkManagerInterface + ".Device.WireGuard"
+
+	DeviceWireGuardPropertyPublicKey  = DeviceWireGuardInterface + ".PublicKey"
+	DeviceWireGuardPropertyListenPort = DeviceWireGuardInterface + ".ListenPort"
+	DeviceWireGuardPropertyFwMark     = DeviceWireGuardInterface + ".FwMark"
+	DeviceWireGuardPropertyPeers      = DeviceWireGuardInterface + ".Peers"
+)
+
+// DeviceWireGuard wraps a WireGuard device, bound to
+// org.freedesktop.NetworkManager.Device.WireGuard.
+type DeviceWireGuard interface {
+	Device
+
+	GetPublicKey() ([]byte, error)
+	GetListenPort() (uint16, error)
+	GetFwMark() (uint32, error)
+
+	// GetPeers returns the configured WireGuard peers, each keyed by its a{sv} field names
+	// (e.g. "public-key", "allowed-ips", "endpoint").
+	GetPeers() ([]map[string]interface{}, error)
+}
+
+func NewDeviceWireGuard(objectPath dbus.ObjectPath) (DeviceWireGuard, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceWireGuard{device: *d}, nil
+}
+
+type deviceWireGuard struct {
+	device
+}
+
+func (d *deviceWireGuard) GetPublicKey() ([]byte, error) {
+	return d.getSliceByteProperty(DeviceWireGuardPropertyPublicKey)
+}
+
+func (d *deviceWireGuard) GetListenPort() (uint16, error) {
+	return d.getUint16Property(DeviceWireGuardPropertyListenPort)
+}
+
+func (d *deviceWireGuard) GetFwMark() (uint32, error) {
+	return d.getUint32Property(DeviceWireGuardPropertyFwMark)
+}
+
+func (d *deviceWireGuard) GetPeers() ([]map[string]interface{}, error) {
+	variantPeers, err := d.getSliceMapStringVariantProperty(DeviceWireGuardPropertyPeers)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]map[string]interface{}, len(variantPeers))
+	for i, variantPeer := range variantPeers {
+		peer := make(map[string]interface{}, len(variantPeer))
+		for name, value := range variantPeer {
+			peer[name] = value.Value()
+		}
+		peers[i] = peer
+	}
+
+	return peers, nil
+}
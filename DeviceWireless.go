@@ -0,0 +1,107 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	DeviceWirelessInterface = NetworkManagerInterface + ".Device.Wireless"
+
+	DeviceWirelessRequestScan = DeviceWirelessInterface + ".RequestScan"
+
+	DeviceWirelessPropertyHwAddress            = DeviceWirelessInterface + ".HwAddress"
+	DeviceWirelessPropertyMode                 = DeviceWirelessInterface + ".Mode"
+	DeviceWirelessPropertyBitrate              = DeviceWirelessInterface + ".Bitrate"
+	DeviceWirelessPropertyAccessPoints         = DeviceWirelessInterface + ".AccessPoints"
+	DeviceWirelessPropertyActiveAccessPoint    = DeviceWirelessInterface + ".ActiveAccessPoint"
+	DeviceWirelessPropertyWirelessCapabilities = DeviceWirelessInterface + ".WirelessCapabilities"
+	DeviceWirelessPropertyLastScan             = DeviceWirelessInterface + ".LastScan"
+)
+
+// DeviceWireless wraps a WiFi-capable device, bound to
+// org.freedesktop.NetworkManager.Device.Wireless. Obtain one from a Device returned by
+// DeviceFactory via a type assertion.
+type DeviceWireless interface {
+	Device
+
+	GetHwAddress() (string, error)
+	GetMode() (uint32, error)
+	GetBitrate() (uint32, error)
+	GetActiveAccessPoint() (AccessPoint, error)
+	GetWirelessCapabilities() (uint32, error)
+
+	// GetAccessPoints returns every access point currently visible to the device.
+	GetAccessPoints() ([]AccessPoint, error)
+
+	// GetLastScan returns the timestamp (in CLOCK_BOOTTIME milliseconds) of the last scan, or
+	// -1 if no scan has completed yet.
+	GetLastScan() (int64, error)
+
+	// RequestScan asks NetworkManager to re-scan for access points. options may carry an "ssids"
+	// key to scan for hidden networks; pass nil for a plain scan.
+	RequestScan(options map[string]interface{}) error
+}
+
+func NewDeviceWireless(objectPath dbus.ObjectPath) (DeviceWireless, error) {
+	d, err := newDevice(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceWireless{device: *d}, nil
+}
+
+type deviceWireless struct {
+	device
+}
+
+func (d *deviceWireless) GetHwAddress() (string, error) {
+	return d.getStringProperty(DeviceWirelessPropertyHwAddress)
+}
+
+func (d *deviceWireless) GetMode() (uint32, error) {
+	return d.getUint32Property(DeviceWirelessPropertyMode)
+}
+
+func (d *deviceWireless) GetBitrate() (uint32, error) {
+	return d.getUint32Property(DeviceWirelessPropertyBitrate)
+}
+
+func (d *deviceWireless) GetActiveAccessPoint() (AccessPoint, error) {
+	path, err := d.getObjectProperty(DeviceWirelessPropertyActiveAccessPoint)
+	if err != nil {
+		return nil, err
+	}
+	return NewAccessPoint(path)
+}
+
+func (d *deviceWireless) GetWirelessCapabilities() (uint32, error) {
+	return d.getUint32Property(DeviceWirelessPropertyWirelessCapabilities)
+}
+
+func (d *deviceWireless) GetAccessPoints() ([]AccessPoint, error) {
+	paths, err := d.getSliceObjectProperty(DeviceWirelessPropertyAccessPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	accessPoints := make([]AccessPoint, len(paths))
+	for i, path := range paths {
+		accessPoints[i], err = NewAccessPoint(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return accessPoints, nil
+}
+
+func (d *deviceWireless) GetLastScan() (int64, error) {
+	return d.getInt64Property(DeviceWirelessPropertyLastScan)
+}
+
+func (d *deviceWireless) RequestScan(options map[string]interface{}) error {
+	if options == nil {
+		options = make(map[string]interface{})
+	}
+	return d.call(nil, DeviceWirelessRequestScan, options)
+}
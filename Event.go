@@ -0,0 +1,130 @@
+package gonetworkmanager
+
+import "github.com/godbus/dbus"
+
+const (
+	signalStateChanged          = NetworkManagerInterface + ".StateChanged"
+	signalDeviceAdded           = NetworkManagerInterface + ".DeviceAdded"
+	signalDeviceRemoved         = NetworkManagerInterface + ".DeviceRemoved"
+	signalActiveConnectionState = "org.freedesktop.NetworkManager.Connection.Active.StateChanged"
+	signalPropertiesChanged     = "org.freedesktop.DBus.Properties.PropertiesChanged"
+)
+
+// Event is the common interface implemented by every typed event SubscribeEvents can deliver.
+type Event interface {
+	isEvent()
+}
+
+// StateChangedEvent reports a change of the overall NetworkManager state.
+type StateChangedEvent struct {
+	State NmState
+}
+
+func (StateChangedEvent) isEvent() {}
+
+// DeviceAddedEvent reports that a device was added to NetworkManager's management.
+type DeviceAddedEvent struct {
+	DevicePath dbus.ObjectPath
+}
+
+func (DeviceAddedEvent) isEvent() {}
+
+// DeviceRemovedEvent reports that a device was removed from NetworkManager's management.
+type DeviceRemovedEvent struct {
+	DevicePath dbus.ObjectPath
+}
+
+func (DeviceRemovedEvent) isEvent() {}
+
+// ActiveConnectionStateChangedEvent reports a state change of an active connection.
+type ActiveConnectionStateChangedEvent struct {
+	Path   dbus.ObjectPath
+	State  uint32
+	Reason uint32
+}
+
+func (ActiveConnectionStateChangedEvent) isEvent() {}
+
+// PropertiesChangedEvent reports a org.freedesktop.DBus.Properties.PropertiesChanged signal,
+// decoded from any object NetworkManager manages.
+type PropertiesChangedEvent struct {
+	Interface   string
+	Path        dbus.ObjectPath
+	Changed     map[string]interface{}
+	Invalidated []string
+}
+
+func (PropertiesChangedEvent) isEvent() {}
+
+// decodeEvent translates a raw D-Bus signal into one of the typed events above. It returns nil
+// for signals it doesn't recognize or can't decode, so callers can simply drop them.
+func decodeEvent(signal *dbus.Signal) Event {
+	switch signal.Name {
+	case signalStateChanged:
+		if len(signal.Body) != 1 {
+			return nil
+		}
+		state, ok := signal.Body[0].(uint32)
+		if !ok {
+			return nil
+		}
+		return StateChangedEvent{State: NmState(state)}
+
+	case signalDeviceAdded:
+		if len(signal.Body) != 1 {
+			return nil
+		}
+		path, ok := signal.Body[0].(dbus.ObjectPath)
+		if !ok {
+			return nil
+		}
+		return DeviceAddedEvent{DevicePath: path}
+
+	case signalDeviceRemoved:
+		if len(signal.Body) != 1 {
+			return nil
+		}
+		path, ok := signal.Body[0].(dbus.ObjectPath)
+		if !ok {
+			return nil
+		}
+		return DeviceRemovedEvent{DevicePath: path}
+
+	case signalActiveConnectionState:
+		if len(signal.Body) != 2 {
+			return nil
+		}
+		state, ok := signal.Body[0].(uint32)
+		reason, ok2 := signal.Body[1].(uint32)
+		if !ok || !ok2 {
+			return nil
+		}
+		return ActiveConnectionStateChangedEvent{Path: signal.Path, State: state, Reason: reason}
+
+	case signalPropertiesChanged:
+		if len(signal.Body) != 3 {
+			return nil
+		}
+		iface, ok := signal.Body[0].(string)
+		changed, ok2 := signal.Body[1].(map[string]dbus.Variant)
+		invalidated, ok3 := signal.Body[2].([]string)
+		if !ok || !ok2 || !ok3 {
+			return nil
+		}
+
+		values := make(map[string]interface{}, len(changed))
+		for name, variant := range changed {
+			values[name] = variant.Value()
+		}
+
+		return PropertiesChangedEvent{
+			Interface:   iface,
+			Path:        signal.Path,
+			Changed:     values,
+			Invalidated: invalidated,
+		}
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,110 @@
+package gonetworkmanager
+
+import (
+	"testing"
+
+	"github.com/godbus/dbus"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	devicePath := dbus.ObjectPath("/org/freedesktop/NetworkManager/Devices/1")
+
+	t.Run("StateChanged", func(t *testing.T) {
+		evt := decodeEvent(&dbus.Signal{
+			Name: signalStateChanged,
+			Body: []interface{}{uint32(70)},
+		})
+		sc, ok := evt.(StateChangedEvent)
+		if !ok {
+			t.Fatalf("decodeEvent() = %#v, want StateChangedEvent", evt)
+		}
+		if sc.State != NmState(70) {
+			t.Errorf("State = %v, want 70", sc.State)
+		}
+	})
+
+	t.Run("DeviceAdded", func(t *testing.T) {
+		evt := decodeEvent(&dbus.Signal{
+			Name: signalDeviceAdded,
+			Body: []interface{}{devicePath},
+		})
+		da, ok := evt.(DeviceAddedEvent)
+		if !ok {
+			t.Fatalf("decodeEvent() = %#v, want DeviceAddedEvent", evt)
+		}
+		if da.DevicePath != devicePath {
+			t.Errorf("DevicePath = %v, want %v", da.DevicePath, devicePath)
+		}
+	})
+
+	t.Run("DeviceRemoved", func(t *testing.T) {
+		evt := decodeEvent(&dbus.Signal{
+			Name: signalDeviceRemoved,
+			Body: []interface{}{devicePath},
+		})
+		dr, ok := evt.(DeviceRemovedEvent)
+		if !ok {
+			t.Fatalf("decodeEvent() = %#v, want DeviceRemovedEvent", evt)
+		}
+		if dr.DevicePath != devicePath {
+			t.Errorf("DevicePath = %v, want %v", dr.DevicePath, devicePath)
+		}
+	})
+
+	t.Run("ActiveConnectionStateChanged", func(t *testing.T) {
+		evt := decodeEvent(&dbus.Signal{
+			Name: signalActiveConnectionState,
+			Path: devicePath,
+			Body: []interface{}{uint32(2), uint32(1)},
+		})
+		ac, ok := evt.(ActiveConnectionStateChangedEvent)
+		if !ok {
+			t.Fatalf("decodeEvent() = %#v, want ActiveConnectionStateChangedEvent", evt)
+		}
+		if ac.Path != devicePath || ac.State != 2 || ac.Reason != 1 {
+			t.Errorf("got %#v, want Path=%v State=2 Reason=1", ac, devicePath)
+		}
+	})
+
+	t.Run("PropertiesChanged", func(t *testing.T) {
+		evt := decodeEvent(&dbus.Signal{
+			Name: signalPropertiesChanged,
+			Path: devicePath,
+			Body: []interface{}{
+				"org.freedesktop.NetworkManager.Device",
+				map[string]dbus.Variant{"State": dbus.MakeVariant(uint32(100))},
+				[]string{"Ip4Config"},
+			},
+		})
+		pc, ok := evt.(PropertiesChangedEvent)
+		if !ok {
+			t.Fatalf("decodeEvent() = %#v, want PropertiesChangedEvent", evt)
+		}
+		if pc.Interface != "org.freedesktop.NetworkManager.Device" {
+			t.Errorf("Interface = %q", pc.Interface)
+		}
+		if pc.Changed["State"] != uint32(100) {
+			t.Errorf("Changed[State] = %v, want 100", pc.Changed["State"])
+		}
+		if len(pc.Invalidated) != 1 || pc.Invalidated[0] != "Ip4Config" {
+			t.Errorf("Invalidated = %v", pc.Invalidated)
+		}
+	})
+
+	t.Run("UnknownSignal", func(t *testing.T) {
+		evt := decodeEvent(&dbus.Signal{Name: "org.freedesktop.NetworkManager.SomethingElse"})
+		if evt != nil {
+			t.Errorf("decodeEvent() = %#v, want nil", evt)
+		}
+	})
+
+	t.Run("MalformedBody", func(t *testing.T) {
+		evt := decodeEvent(&dbus.Signal{
+			Name: signalStateChanged,
+			Body: []interface{}{"not-a-uint32"},
+		})
+		if evt != nil {
+			t.Errorf("decodeEvent() = %#v, want nil", evt)
+		}
+	})
+}
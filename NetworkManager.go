@@ -15,6 +15,11 @@ const (
 	NetworkManagerAddAndActivateConnection = NetworkManagerInterface + ".AddAndActivateConnection"
 	NetworkManagerPropertyState            = NetworkManagerInterface + ".State"
 	NetworkManagerPropertyActiveConnection = NetworkManagerInterface + ".ActiveConnections"
+
+	NetworkManagerCheckpointCreate                = NetworkManagerInterface + ".CheckpointCreate"
+	NetworkManagerCheckpointDestroy               = NetworkManagerInterface + ".CheckpointDestroy"
+	NetworkManagerCheckpointRollback              = NetworkManagerInterface + ".CheckpointRollback"
+	NetworkManagerCheckpointAdjustRollbackTimeout = NetworkManagerInterface + ".CheckpointAdjustRollbackTimeout"
 )
 
 type NetworkManager interface {
@@ -33,33 +38,43 @@ type NetworkManager interface {
 	// ActivateWirelessConnection requests activating access point to network device
 	ActivateWirelessConnection(connection Connection, device Device, accessPoint AccessPoint) (ActiveConnection, error)
 
-	// AddAndActivateWirelessConnection adds a new connection profile to the network device it has been
-	// passed. It then activates the connection to the passed access point. The first paramter contains
-	// additional information for the connection (most propably the credentials).
-	// Example contents for connection are:
-	// connection := make(map[string]map[string]interface{})
-	// connection["802-11-wireless"] = make(map[string]interface{})
-	// connection["802-11-wireless"]["security"] = "802-11-wireless-security"
-	// connection["802-11-wireless-security"] = make(map[string]interface{})
-	// connection["802-11-wireless-security"]["key-mgmt"] = "wpa-psk"
-	// connection["802-11-wireless-security"]["psk"] = password
-	AddAndActivateWirelessConnection(connection map[string]map[string]interface{}, device Device, accessPoint AccessPoint) (ac ActiveConnection, err error)
-
-	// AddAndActivateWirelessConnection adds a new connection profile to the network device it has been
-	// passed. It then activates the connection to the passed access point. The first paramter contains
-	// additional information for the connection (most propably the credentials).
-	// Example contents for connection are:
-	// connection := make(map[string]map[string]interface{})
-	// connection["802-11-wireless"] = make(map[string]interface{})
-	// connection["802-11-wireless"]["security"] = "802-11-wireless-security"
-	// connection["802-11-wireless-security"] = make(map[string]interface{})
-	// connection["802-11-wireless-security"]["key-mgmt"] = "wpa-psk"
-	// connection["802-11-wireless-security"]["psk"] = password
-	AddAndActivateWirelessConnection(connection map[string]map[string]interface{}, device Device, accessPoint AccessPoint) (ac ActiveConnection, err error)
-
+	// AddAndActivateWirelessConnection adds a new connection profile to the network device it has
+	// been passed. It then activates the connection to the passed access point. The settings
+	// parameter carries the profile to add (most probably including the credentials) built with
+	// NewConnectionSettings, e.g.:
+	// settings := NewConnectionSettings().
+	//     SetWireless(ssid, false).
+	//     SetWirelessSecurityWPAPSK(password)
+	AddAndActivateWirelessConnection(settings ConnectionSettings, device Device, accessPoint AccessPoint) (ac ActiveConnection, err error)
+
+	// Subscribe returns the raw D-Bus signal channel. Prefer SubscribeEvents, which decodes
+	// signals into typed events; Subscribe remains available as a fallback for signals
+	// SubscribeEvents doesn't yet recognize.
 	Subscribe() <-chan *dbus.Signal
 	Unsubscribe()
 
+	// SubscribeEvents returns a channel of typed events, decoded from the raw D-Bus signals
+	// NetworkManager emits (state changes, device add/remove, active connection state changes,
+	// and PropertiesChanged on any managed object). Signals that can't be decoded are dropped.
+	SubscribeEvents() <-chan Event
+
+	// CheckpointCreate creates a checkpoint of the current networking configuration for the
+	// given devices. If rollback is not explicitly requested beforehand via CheckpointRollback,
+	// the checkpoint automatically rolls back all configuration changes made after it was
+	// created once rollbackTimeout (in seconds) has elapsed, unless rollbackTimeout is zero.
+	CheckpointCreate(devices []Device, rollbackTimeout uint32, flags uint32) (Checkpoint, error)
+
+	// CheckpointDestroy destroys a checkpoint without performing a rollback.
+	CheckpointDestroy(cp Checkpoint) error
+
+	// CheckpointRollback rolls back the configuration of the devices that were captured in the
+	// given checkpoint, returning the per-device rollback result keyed by device D-Bus path.
+	CheckpointRollback(cp Checkpoint) (map[string]uint32, error)
+
+	// CheckpointAdjustRollbackTimeout resets the rollback timeout of the given checkpoint to
+	// addTimeout seconds from now, or disables the automatic rollback if addTimeout is zero.
+	CheckpointAdjustRollbackTimeout(cp Checkpoint, addTimeout uint32) error
+
 	MarshalJSON() ([]byte, error)
 }
 
@@ -123,11 +138,11 @@ func (n *networkManager) ActivateWirelessConnection(c Connection, d Device, ap A
 	return nil, n.call(&opath, NetworkManagerActivateConnection, c.GetPath(), d.GetPath(), ap.GetPath())
 }
 
-func (n *networkManager) AddAndActivateWirelessConnection(connection map[string]map[string]interface{}, d Device, ap AccessPoint) (ac ActiveConnection, err error) {
+func (n *networkManager) AddAndActivateWirelessConnection(settings ConnectionSettings, d Device, ap AccessPoint) (ac ActiveConnection, err error) {
 	var opath1 dbus.ObjectPath
 	var opath2 dbus.ObjectPath
 
-	err = n.call2(&opath1, &opath2, NetworkManagerAddAndActivateConnection, connection, d.GetPath(), ap.GetPath())
+	err = n.call2(&opath1, &opath2, NetworkManagerAddAndActivateConnection, map[string]map[string]interface{}(settings), d.GetPath(), ap.GetPath())
 	if err != nil {
 		return
 	}
@@ -139,20 +154,33 @@ func (n *networkManager) AddAndActivateWirelessConnection(connection map[string]
 	return
 }
 
-func (n *networkManager) AddAndActivateWirelessConnection(connection map[string]map[string]interface{}, d Device, ap AccessPoint) (ac ActiveConnection, err error) {
-	var opath1 dbus.ObjectPath
-	var opath2 dbus.ObjectPath
-
-	err = n.callError2(&opath1, &opath2, NetworkManagerAddAndActivateConnection, connection, d.GetPath(), ap.GetPath())
-	if err != nil {
-		return
+func (n *networkManager) CheckpointCreate(devices []Device, rollbackTimeout uint32, flags uint32) (Checkpoint, error) {
+	devicePaths := make([]dbus.ObjectPath, len(devices))
+	for i, d := range devices {
+		devicePaths[i] = d.GetPath()
 	}
 
-	ac, err = NewActiveConnection(opath2)
+	var cpPath dbus.ObjectPath
+	err := n.call(&cpPath, NetworkManagerCheckpointCreate, devicePaths, rollbackTimeout, flags)
 	if err != nil {
-		return
+		return nil, err
 	}
-	return
+
+	return NewCheckpoint(cpPath)
+}
+
+func (n *networkManager) CheckpointDestroy(cp Checkpoint) error {
+	return n.call(nil, NetworkManagerCheckpointDestroy, cp.GetPath())
+}
+
+func (n *networkManager) CheckpointRollback(cp Checkpoint) (map[string]uint32, error) {
+	var result map[string]uint32
+	err := n.call(&result, NetworkManagerCheckpointRollback, cp.GetPath())
+	return result, err
+}
+
+func (n *networkManager) CheckpointAdjustRollbackTimeout(cp Checkpoint, addTimeout uint32) error {
+	return n.call(nil, NetworkManagerCheckpointAdjustRollbackTimeout, cp.GetPath(), addTimeout)
 }
 
 func (n *networkManager) Subscribe() <-chan *dbus.Signal {
@@ -172,6 +200,22 @@ func (n *networkManager) Unsubscribe() {
 	n.sigChan = nil
 }
 
+func (n *networkManager) SubscribeEvents() <-chan Event {
+	raw := n.Subscribe()
+	events := make(chan Event, 10)
+
+	go func() {
+		defer close(events)
+		for signal := range raw {
+			if event := decodeEvent(signal); event != nil {
+				events <- event
+			}
+		}
+	}()
+
+	return events
+}
+
 func (n *networkManager) MarshalJSON() ([]byte, error) {
 	NetworkState, err := n.GetState()
 	if err != nil {
@@ -0,0 +1,102 @@
+package gonetworkmanager
+
+import (
+	"github.com/godbus/dbus"
+)
+
+const (
+	AgentManagerInterface  = NetworkManagerInterface + ".AgentManager"
+	AgentManagerObjectPath = "/org/freedesktop/NetworkManager/AgentManager"
+
+	AgentManagerRegister                 = AgentManagerInterface + ".Register"
+	AgentManagerRegisterWithCapabilities = AgentManagerInterface + ".RegisterWithCapabilities"
+	AgentManagerUnregister               = AgentManagerInterface + ".Unregister"
+
+	SecretAgentInterface  = NetworkManagerInterface + ".SecretAgent"
+	SecretAgentObjectPath = "/org/freedesktop/NetworkManager/SecretAgent"
+)
+
+// SecretProvider is implemented by applications that want to hand out connection secrets
+// (PSKs, passwords, ...) on demand, e.g. to prompt a user interactively, instead of embedding
+// them into a ConnectionSettings ahead of time.
+type SecretProvider interface {
+	GetSecrets(settings ConnectionSettings, connPath dbus.ObjectPath, settingName string, hints []string, flags uint32) (map[string]map[string]interface{}, error)
+}
+
+// SecretAgent registers a SecretProvider with NetworkManager's AgentManager so that
+// NetworkManager can call back into it whenever a connection needs secrets it doesn't have.
+type SecretAgent interface {
+	// Register registers the agent with default capabilities.
+	Register() error
+
+	// RegisterWithCapabilities registers the agent, advertising the given NMSecretAgentCapabilities.
+	RegisterWithCapabilities(capabilities uint32) error
+
+	// Unregister removes the agent from NetworkManager's agent registry.
+	Unregister() error
+}
+
+// NewSecretAgent creates a SecretAgent identified by identifier (a reverse-DNS style string,
+// e.g. "org.example.myagent") and exports provider on the same (system bus) connection used
+// for the AgentManager calls, so NetworkManager can reach it once Register or
+// RegisterWithCapabilities is called.
+func NewSecretAgent(identifier string, provider SecretProvider) (SecretAgent, error) {
+	var a secretAgent
+	a.identifier = identifier
+
+	err := a.init(AgentManagerInterface, AgentManagerObjectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.conn.Export(&secretAgentServer{provider: provider}, SecretAgentObjectPath, SecretAgentInterface)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+type secretAgent struct {
+	dbusBase
+	identifier string
+}
+
+func (a *secretAgent) Register() error {
+	return a.call(nil, AgentManagerRegister, a.identifier)
+}
+
+func (a *secretAgent) RegisterWithCapabilities(capabilities uint32) error {
+	return a.call(nil, AgentManagerRegisterWithCapabilities, a.identifier, capabilities)
+}
+
+func (a *secretAgent) Unregister() error {
+	return a.call(nil, AgentManagerUnregister)
+}
+
+// secretAgentServer is exported at SecretAgentObjectPath and implements
+// org.freedesktop.NetworkManager.SecretAgent, the interface NetworkManager calls into once
+// this agent is registered.
+type secretAgentServer struct {
+	provider SecretProvider
+}
+
+func (s *secretAgentServer) GetSecrets(settings map[string]map[string]interface{}, connPath dbus.ObjectPath, settingName string, hints []string, flags uint32) (map[string]map[string]interface{}, *dbus.Error) {
+	secrets, err := s.provider.GetSecrets(ConnectionSettings(settings), connPath, settingName, hints, flags)
+	if err != nil {
+		return nil, dbus.MakeFailedError(err)
+	}
+	return secrets, nil
+}
+
+func (s *secretAgentServer) SaveSecrets(settings map[string]map[string]interface{}, connPath dbus.ObjectPath) *dbus.Error {
+	return dbus.NewError(SecretAgentInterface+".NotSupported", []interface{}{"SaveSecrets is not supported by this agent"})
+}
+
+func (s *secretAgentServer) DeleteSecrets(settings map[string]map[string]interface{}, connPath dbus.ObjectPath) *dbus.Error {
+	return dbus.NewError(SecretAgentInterface+".NotSupported", []interface{}{"DeleteSecrets is not supported by this agent"})
+}
+
+func (s *secretAgentServer) CancelGetSecrets(connPath dbus.ObjectPath, settingName string) *dbus.Error {
+	return nil
+}
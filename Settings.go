@@ -0,0 +1,92 @@
+package gonetworkmanager
+
+import (
+	"encoding/json"
+
+	"github.com/godbus/dbus"
+)
+
+const (
+	SettingsInterface  = NetworkManagerInterface + ".Settings"
+	SettingsObjectPath = "/org/freedesktop/NetworkManager/Settings"
+
+	SettingsListConnections     = SettingsInterface + ".ListConnections"
+	SettingsAddConnection       = SettingsInterface + ".AddConnection"
+	SettingsGetConnectionByUUID = SettingsInterface + ".GetConnectionByUuid"
+)
+
+// Settings manages the saved connection profiles known to NetworkManager, bound to
+// org.freedesktop.NetworkManager.Settings.
+type Settings interface {
+	// ListConnections returns all saved connection profiles.
+	ListConnections() ([]Connection, error)
+
+	// AddConnection stores a new connection profile and returns it.
+	AddConnection(settings ConnectionSettings) (Connection, error)
+
+	// GetConnectionByUUID returns the saved connection profile with the given UUID.
+	GetConnectionByUUID(uuid string) (Connection, error)
+
+	MarshalJSON() ([]byte, error)
+}
+
+func NewSettings() (Settings, error) {
+	var s settings
+	return &s, s.init(SettingsInterface, SettingsObjectPath)
+}
+
+type settings struct {
+	dbusBase
+}
+
+func (s *settings) ListConnections() ([]Connection, error) {
+	var paths []dbus.ObjectPath
+
+	err := s.call(&paths, SettingsListConnections)
+	if err != nil {
+		return nil, err
+	}
+
+	connections := make([]Connection, len(paths))
+	for i, path := range paths {
+		connections[i], err = NewConnection(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return connections, nil
+}
+
+func (s *settings) AddConnection(cs ConnectionSettings) (Connection, error) {
+	var path dbus.ObjectPath
+
+	err := s.call(&path, SettingsAddConnection, map[string]map[string]interface{}(cs))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewConnection(path)
+}
+
+func (s *settings) GetConnectionByUUID(uuid string) (Connection, error) {
+	var path dbus.ObjectPath
+
+	err := s.call(&path, SettingsGetConnectionByUUID, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewConnection(path)
+}
+
+func (s *settings) MarshalJSON() ([]byte, error) {
+	connections, err := s.ListConnections()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"Connections": connections,
+	})
+}